@@ -0,0 +1,102 @@
+package cron
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestChainThenOrdersOutermostFirst(t *testing.T) {
+	var order []int
+	wrap := func(n int) JobWrapper {
+		return func(j Job) Job {
+			return FuncJob(func() {
+				order = append(order, n)
+				j.Run()
+			})
+		}
+	}
+
+	j := NewChain(wrap(1), wrap(2), wrap(3)).Then(FuncJob(func() {}))
+	j.Run()
+
+	expected := []int{1, 2, 3}
+	for i, n := range expected {
+		if order[i] != n {
+			t.Fatalf("expected order %v, got %v", expected, order)
+		}
+	}
+}
+
+func TestChainRecoverRecoversPanic(t *testing.T) {
+	logger := DiscardLogger
+	didPanic := true
+
+	j := NewChain(Recover(logger)).Then(FuncJob(func() {
+		panic("YOLO")
+	}))
+
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				didPanic = true
+			} else {
+				didPanic = false
+			}
+		}()
+		j.Run()
+	}()
+
+	if didPanic {
+		t.Error("expected Recover to stop the panic from propagating")
+	}
+}
+
+func TestChainSkipIfStillRunningSkipsOverlap(t *testing.T) {
+	logger := DiscardLogger
+	var calls int32
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	j := NewChain(SkipIfStillRunning(logger)).Then(FuncJob(func() {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		<-release
+	}))
+
+	go func() {
+		j.Run()
+		close(done)
+	}()
+
+	<-started
+	j.Run() // should be skipped, the first invocation is still running
+	close(release)
+	<-done
+
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("expected the overlapping run to be skipped, got %d calls", n)
+	}
+}
+
+func TestChainDelayIfStillRunningSerializes(t *testing.T) {
+	logger := DiscardLogger
+	var calls []int
+
+	j := NewChain(DelayIfStillRunning(logger)).Then(FuncJob(func() {
+		calls = append(calls, 1)
+		time.Sleep(20 * time.Millisecond)
+	}))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() { defer wg.Done(); j.Run() }()
+	go func() { defer wg.Done(); j.Run() }()
+	wg.Wait()
+
+	if len(calls) != 2 {
+		t.Errorf("expected both runs to complete, got %d calls", len(calls))
+	}
+}
@@ -0,0 +1,402 @@
+// Package cron implements a cron spec parser and job runner.
+package cron
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Cron keeps track of any number of entries, invoking the associated Job as
+// specified by its Schedule. It may be started, stopped, and the entries may
+// be inspected while running.
+type Cron struct {
+	entries  []*Entry
+	chain    Chain
+	parser   ScheduleParser
+	locker   Locker
+	lockTTL  time.Duration
+	add      chan *Entry
+	remove   chan string
+	pause    chan pauseRequest
+	snapshot chan chan []Entry
+	stop     chan struct{}
+	running  bool
+	logger   Logger
+	location *time.Location
+
+	// mu guards running and entries against concurrent access between the
+	// direct, not-running call paths (addEntry, removeEntry, setPaused,
+	// entrySnapshot called outside of run) and each other, and between
+	// Start/Stop and everything that branches on running - for example a
+	// PauseOnError cooldown firing ResumeJob from its own goroutine
+	// regardless of whether Start/Stop has been called since. While the
+	// Cron is running, the entries helpers are only ever invoked from the
+	// single run goroutine, so mu is uncontended in that case.
+	mu sync.Mutex
+}
+
+// pauseRequest toggles Entry.Paused for the named entry from outside the
+// scheduler goroutine.
+type pauseRequest struct {
+	name   string
+	paused bool
+}
+
+// isRunning reports whether the Cron is currently started.
+func (c *Cron) isRunning() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.running
+}
+
+// setRunning updates whether the Cron is currently started.
+func (c *Cron) setRunning(running bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.running = running
+}
+
+// New returns a new Cron job runner, configured with the given Options. The
+// defaults, absent any Option to the contrary, are the local time zone, the
+// 6-field parser understood by the package-level Parse, DefaultLogger, and
+// a Chain that only recovers panics.
+func New(opts ...Option) *Cron {
+	c := &Cron{
+		add:      make(chan *Entry),
+		remove:   make(chan string),
+		pause:    make(chan pauseRequest),
+		snapshot: make(chan chan []Entry),
+		stop:     make(chan struct{}),
+		running:  false,
+		logger:   DefaultLogger,
+		location: time.Local,
+		parser:   defaultParser{},
+		lockTTL:  defaultLockTTL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if len(c.chain.wrappers) == 0 {
+		c.chain = NewChain(Recover(c.logger))
+	}
+	return c
+}
+
+// NewWithLocation returns a new Cron job runner in the given time zone. It
+// is retained for backward compatibility; New(WithLocation(loc)) is
+// equivalent.
+func NewWithLocation(location *time.Location) *Cron {
+	return New(WithLocation(location))
+}
+
+// AddFunc adds a func to the Cron to be run on the given schedule, under the
+// given name. It is equivalent to AddJob(spec, FuncJob(cmd), name).
+func (c *Cron) AddFunc(spec string, cmd func(), name string) error {
+	return c.AddJob(spec, FuncJob(cmd), name)
+}
+
+// AddJob adds a Job to the Cron to be run on the given schedule, under the
+// given name.
+func (c *Cron) AddJob(spec string, cmd Job, name string) error {
+	schedule, err := c.parser.Parse(spec)
+	if err != nil {
+		c.logger.Error(err, "failed to parse schedule", "name", name, "spec", spec)
+		return err
+	}
+	c.Schedule(schedule, cmd, name)
+	return nil
+}
+
+// AddFuncWithOptions is like AddFunc, but applies the given EntryOptions
+// (RunAtStart, MaxConcurrent, PauseOnError, ...) to the resulting entry.
+func (c *Cron) AddFuncWithOptions(spec string, name string, fn func(), opts ...EntryOption) error {
+	schedule, err := c.parser.Parse(spec)
+	if err != nil {
+		c.logger.Error(err, "failed to parse schedule", "name", name, "spec", spec)
+		return err
+	}
+
+	entry := &Entry{
+		Schedule:   schedule,
+		Name:       name,
+		Job:        FuncJob(fn),
+		WrappedJob: FuncJob(fn),
+	}
+	for _, opt := range opts {
+		opt(c, entry)
+	}
+	entry.WrappedJob = c.chain.Then(entry.WrappedJob)
+
+	if !c.isRunning() {
+		c.addEntry(entry)
+		return nil
+	}
+	c.add <- entry
+	return nil
+}
+
+// AddJobWithChain is like AddJob, but decorates cmd with chain instead of
+// the Cron's default chain.
+func (c *Cron) AddJobWithChain(spec string, name string, cmd Job, chain Chain) error {
+	schedule, err := c.parser.Parse(spec)
+	if err != nil {
+		c.logger.Error(err, "failed to parse schedule", "name", name, "spec", spec)
+		return err
+	}
+	c.ScheduleWithChain(schedule, name, cmd, chain)
+	return nil
+}
+
+// Schedule adds a Job to the Cron to be run on the given schedule, under the
+// given name, decorated with the Cron's default Chain. If an entry with the
+// same name is already registered, this is a no-op.
+func (c *Cron) Schedule(schedule Schedule, cmd Job, name string) {
+	c.ScheduleWithChain(schedule, name, cmd, c.chain)
+}
+
+// ScheduleWithChain is like Schedule, but decorates cmd with chain instead
+// of the Cron's default chain.
+func (c *Cron) ScheduleWithChain(schedule Schedule, name string, cmd Job, chain Chain) {
+	entry := &Entry{
+		Schedule:   schedule,
+		Name:       name,
+		Job:        cmd,
+		WrappedJob: chain.Then(cmd),
+	}
+	if !c.isRunning() {
+		c.addEntry(entry)
+		return
+	}
+	c.add <- entry
+}
+
+// addEntry appends entry to the entry list, unless an entry with the same
+// name is already registered, and reports whether it was added.
+func (c *Cron) addEntry(entry *Entry) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		if e.Name == entry.Name {
+			return false
+		}
+	}
+	c.entries = append(c.entries, entry)
+	c.logger.Info("entry added", "name", entry.Name, "next", entry.Next)
+	return true
+}
+
+// RemoveJob removes the entry with the given name from the Cron, if any.
+func (c *Cron) RemoveJob(name string) {
+	if !c.isRunning() {
+		c.removeEntry(name)
+		return
+	}
+	c.remove <- name
+}
+
+func (c *Cron) removeEntry(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := c.entries[:0]
+	for _, e := range c.entries {
+		if e.Name != name {
+			entries = append(entries, e)
+		}
+	}
+	if len(entries) != len(c.entries) {
+		c.logger.Info("entry removed", "name", name)
+	}
+	c.entries = entries
+}
+
+// PauseJob marks the named entry as paused: the scheduler will keep
+// advancing its Next activation time on schedule, but will not run its job,
+// until ResumeJob is called.
+func (c *Cron) PauseJob(name string) {
+	if !c.isRunning() {
+		c.setPaused(name, true)
+		return
+	}
+	c.pause <- pauseRequest{name: name, paused: true}
+}
+
+// ResumeJob un-pauses the named entry, so its job runs on schedule again.
+func (c *Cron) ResumeJob(name string) {
+	if !c.isRunning() {
+		c.setPaused(name, false)
+		return
+	}
+	c.pause <- pauseRequest{name: name, paused: false}
+}
+
+func (c *Cron) setPaused(name string, paused bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, e := range c.entries {
+		if e.Name == name {
+			e.Paused = paused
+			c.logger.Info("entry paused toggled", "name", name, "paused", paused)
+			return
+		}
+	}
+}
+
+// Entries returns a snapshot of the Cron's entries.
+func (c *Cron) Entries() []Entry {
+	if c.isRunning() {
+		reply := make(chan []Entry, 1)
+		c.snapshot <- reply
+		return <-reply
+	}
+	return c.entrySnapshot()
+}
+
+// Entry returns the entry registered under the given name, and reports
+// whether one was found. It is equivalent to scanning the slice returned by
+// Entries for a matching Name.
+func (c *Cron) Entry(name string) (Entry, bool) {
+	for _, e := range c.Entries() {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+func (c *Cron) entrySnapshot() []Entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := make([]Entry, len(c.entries))
+	for i, e := range c.entries {
+		entries[i] = *e
+	}
+	return entries
+}
+
+// Location returns the time zone location used by this Cron.
+func (c *Cron) Location() *time.Location {
+	return c.location
+}
+
+// Start starts the Cron scheduler in its own goroutine, or no-ops if
+// already started.
+func (c *Cron) Start() {
+	if c.isRunning() {
+		return
+	}
+	c.setRunning(true)
+	c.logger.Info("start")
+	go c.run()
+}
+
+func (c *Cron) now() time.Time {
+	return time.Now().In(c.location)
+}
+
+// runEntry runs e's WrappedJob, first acquiring the Cron's Locker (if any)
+// for "cron:" + e.Name. If the lock cannot be acquired, the tick is skipped
+// and logged instead of run.
+func (c *Cron) runEntry(e *Entry) {
+	if c.locker == nil {
+		e.WrappedJob.Run()
+		return
+	}
+
+	release, ok, err := c.locker.Acquire(context.Background(), "cron:"+e.Name, c.lockTTL)
+	if err != nil {
+		c.logger.Error(err, "failed to acquire lock, skipping", "name", e.Name)
+		return
+	}
+	if !ok {
+		c.logger.Info("lock held elsewhere, skipping", "name", e.Name)
+		return
+	}
+	defer release()
+	e.WrappedJob.Run()
+}
+
+// run is the Cron's main scheduling loop.
+func (c *Cron) run() {
+	now := c.now()
+	for _, entry := range c.entries {
+		entry.Next = entry.Schedule.Next(now)
+		if entry.runAtStart {
+			c.logger.Info("run-at-start", "name", entry.Name)
+			go c.runEntry(entry)
+		}
+	}
+
+	for {
+		sort.Sort(byTime(c.entries))
+
+		var timer *time.Timer
+		if len(c.entries) == 0 || c.entries[0].Next.IsZero() {
+			timer = time.NewTimer(100000 * time.Hour)
+		} else {
+			timer = time.NewTimer(c.entries[0].Next.Sub(now))
+		}
+
+		for {
+			select {
+			case now = <-timer.C:
+				now = now.In(c.location)
+				c.logger.Info("wake", "now", now)
+				for _, e := range c.entries {
+					if e.Next.After(now) || e.Next.IsZero() {
+						break
+					}
+					e.Prev = e.Next
+					e.Next = e.Schedule.Next(now)
+					if e.Paused {
+						continue
+					}
+					c.logger.Info("run", "name", e.Name, "next", e.Next)
+					go c.runEntry(e)
+				}
+
+			case newEntry := <-c.add:
+				timer.Stop()
+				now = c.now()
+				newEntry.Next = newEntry.Schedule.Next(now)
+				if c.addEntry(newEntry) && newEntry.runAtStart {
+					c.logger.Info("run-at-start", "name", newEntry.Name)
+					go c.runEntry(newEntry)
+				}
+
+			case name := <-c.remove:
+				timer.Stop()
+				now = c.now()
+				c.removeEntry(name)
+
+			case req := <-c.pause:
+				timer.Stop()
+				now = c.now()
+				c.setPaused(req.name, req.paused)
+
+			case reply := <-c.snapshot:
+				reply <- c.entrySnapshot()
+				continue
+
+			case <-c.stop:
+				timer.Stop()
+				return
+			}
+
+			break
+		}
+	}
+}
+
+// Stop stops the Cron scheduler, waiting for any running jobs to be
+// launched (but not to complete). Calling Stop on a Cron that isn't running
+// is a no-op.
+func (c *Cron) Stop() {
+	if !c.isRunning() {
+		return
+	}
+	c.stop <- struct{}{}
+	c.setRunning(false)
+	c.logger.Info("stop")
+}
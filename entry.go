@@ -0,0 +1,58 @@
+package cron
+
+import "time"
+
+// Entry consists of a schedule and the job to be executed on that schedule,
+// plus bookkeeping about when it last ran and when it runs next.
+type Entry struct {
+	// Name uniquely identifies this entry among those registered with a
+	// Cron. Adding a second entry with a name already in use is a no-op.
+	Name string
+
+	// Schedule on which this job should be run.
+	Schedule Schedule
+
+	// Next is the next time the job will run, or the zero time if Cron has
+	// not been started or this entry's schedule is unsatisfiable. It is
+	// part of the stable, documented contract of the Entry values returned
+	// by Entries and Entry: callers may rely on it to display or validate
+	// "next run at ..." without re-parsing the spec.
+	Next time.Time
+
+	// Prev is the last time this job was run, or the zero time if never.
+	Prev time.Time
+
+	// Job is the thing to run, exactly as provided by the caller of AddFunc
+	// or AddJob.
+	Job Job
+
+	// WrappedJob is Job decorated by the entry's JobWrapper Chain. It is
+	// what actually gets run on each activation.
+	WrappedJob Job
+
+	// Paused, when true, causes the scheduler to skip this entry's
+	// activations (advancing Next as normal) without removing it from the
+	// entry list. Toggle it via Cron.PauseJob / Cron.ResumeJob.
+	Paused bool
+
+	// runAtStart causes the scheduler to invoke WrappedJob once, in its own
+	// goroutine, as soon as the entry becomes active, in addition to its
+	// normal schedule. Set via the RunAtStart EntryOption.
+	runAtStart bool
+}
+
+// byTime sorts a slice of Entry pointers by Next activation time, with the
+// zero time (unsatisfiable schedules) sorted to the end.
+type byTime []*Entry
+
+func (s byTime) Len() int      { return len(s) }
+func (s byTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byTime) Less(i, j int) bool {
+	if s[i].Next.IsZero() {
+		return false
+	}
+	if s[j].Next.IsZero() {
+		return true
+	}
+	return s[i].Next.Before(s[j].Next)
+}
@@ -0,0 +1,41 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronEntryLookupByName(t *testing.T) {
+	cron := New()
+	cron.AddFunc("* * * * * ?", func() {}, "named-job")
+
+	entry, ok := cron.Entry("named-job")
+	if !ok {
+		t.Fatal("expected to find entry by name")
+	}
+	if entry.Name != "named-job" {
+		t.Errorf("expected name %q, got %q", "named-job", entry.Name)
+	}
+
+	if _, ok := cron.Entry("does-not-exist"); ok {
+		t.Error("expected no entry for an unregistered name")
+	}
+}
+
+func TestNextScheduledTime(t *testing.T) {
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	next, err := NextScheduledTime("0 30 4 1 * ?", from)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := time.Date(2026, time.January, 1, 4, 30, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("expected %v, got %v", want, next)
+	}
+
+	if _, err := NextScheduledTime("not a spec", from); err == nil {
+		t.Error("expected an error for an invalid spec")
+	}
+}
@@ -0,0 +1,98 @@
+package cron
+
+import (
+	"fmt"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+// EntryOption configures an individual entry registered via
+// AddFuncWithOptions.
+type EntryOption func(*Cron, *Entry)
+
+// RunAtStart causes the job to be invoked once, in its own goroutine, as
+// soon as the entry becomes active (when Cron.Start is called, or
+// immediately if the Cron is already running), in addition to its normal
+// schedule. This mirrors config formats that offer a "run once at startup,
+// then on schedule" knob.
+func RunAtStart() EntryOption {
+	return func(c *Cron, e *Entry) {
+		e.runAtStart = true
+	}
+}
+
+// MaxConcurrent limits how many invocations of this entry's job may be in
+// flight at once; additional ticks block until a slot frees up.
+func MaxConcurrent(n int) EntryOption {
+	return func(c *Cron, e *Entry) {
+		sem := make(chan struct{}, n)
+		inner := e.WrappedJob
+		e.WrappedJob = FuncJob(func() {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			inner.Run()
+		})
+	}
+}
+
+// PauseOnError automatically pauses this entry after threshold consecutive
+// failures - a panic, or a returned error from a Job that also implements
+// interface{ RunE() error } - resuming it again after cooldown. This
+// mirrors the delivery-error backoff pattern used by queues that talk to a
+// flaky downstream: stop hammering it, then give it a chance to recover.
+func PauseOnError(threshold int, cooldown time.Duration) EntryOption {
+	return func(c *Cron, e *Entry) {
+		var failures int32
+		name := e.Name
+		inner := e.WrappedJob
+		e.WrappedJob = FuncJob(func() {
+			err, stack := runJob(inner)
+			if err == nil {
+				atomic.StoreInt32(&failures, 0)
+				return
+			}
+			if stack != "" {
+				c.logger.Error(err, "panic running job", "name", name, "stack", stack)
+			} else {
+				c.logger.Error(err, "job failed", "name", name)
+			}
+			if int(atomic.AddInt32(&failures, 1)) < threshold {
+				return
+			}
+			atomic.StoreInt32(&failures, 0)
+			c.logger.Error(err, "pausing entry after repeated failures", "name", name, "threshold", threshold)
+			c.PauseJob(name)
+			time.AfterFunc(cooldown, func() {
+				c.logger.Info("auto-resuming entry", "name", name)
+				c.ResumeJob(name)
+			})
+		})
+	}
+}
+
+// runJob runs j, reporting any error from its optional RunE() error method.
+// A panic is recovered and reported the same way, so callers such as
+// PauseOnError see it as an ordinary failure instead of having it
+// propagate; stack captures the same kind of trace Recover logs, and is
+// only set when err came from a recovered panic.
+func runJob(j Job) (err error, stack string) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = e
+			} else {
+				err = fmt.Errorf("%v", r)
+			}
+			const size = 64 << 10
+			buf := make([]byte, size)
+			buf = buf[:runtime.Stack(buf, false)]
+			stack = string(buf)
+		}
+	}()
+	if re, ok := j.(interface{ RunE() error }); ok {
+		return re.RunE(), ""
+	}
+	j.Run()
+	return nil, ""
+}
@@ -0,0 +1,126 @@
+package cron
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunAtStartRunsImmediately(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	cron := New()
+	cron.AddFuncWithOptions("@every 1h", "start1", func() { wg.Done() }, RunAtStart())
+	cron.Start()
+	defer cron.Stop()
+
+	select {
+	case <-time.After(OneSecond):
+		t.Error("expected RunAtStart to fire the job immediately")
+	case <-wait(wg):
+	}
+}
+
+func TestMaxConcurrentLimitsOverlap(t *testing.T) {
+	var running int32
+	var sawOverlap int32
+
+	release := make(chan struct{})
+	cron := New()
+	cron.AddFuncWithOptions("* * * * * ?", "limited", func() {
+		if atomic.AddInt32(&running, 1) > 1 {
+			atomic.StoreInt32(&sawOverlap, 1)
+		}
+		<-release
+		atomic.AddInt32(&running, -1)
+	}, MaxConcurrent(1))
+
+	cron.Start()
+	defer cron.Stop()
+
+	time.Sleep(2 * OneSecond)
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+
+	if atomic.LoadInt32(&sawOverlap) != 0 {
+		t.Error("expected MaxConcurrent(1) to prevent overlapping runs")
+	}
+}
+
+type failingJob struct {
+	failuresLeft int32
+}
+
+func (f *failingJob) Run() { _ = f.RunE() }
+
+func (f *failingJob) RunE() error {
+	if atomic.AddInt32(&f.failuresLeft, -1) >= 0 {
+		return errors.New("boom")
+	}
+	return nil
+}
+
+type panickingJob struct {
+	panicsLeft int32
+}
+
+func (p *panickingJob) Run() {
+	if atomic.AddInt32(&p.panicsLeft, -1) >= 0 {
+		panic("boom")
+	}
+}
+
+func TestPauseOnErrorCountsPanics(t *testing.T) {
+	job := &panickingJob{panicsLeft: 100}
+
+	cron := New()
+	entry := &Entry{Name: "panicky", Job: job, WrappedJob: job}
+	opt := PauseOnError(2, time.Hour)
+	opt(cron, entry)
+	cron.addEntry(entry)
+
+	entry.WrappedJob.Run()
+	entry.WrappedJob.Run()
+
+	e, ok := cron.Entry("panicky")
+	if !ok {
+		t.Fatal("expected entry to exist")
+	}
+	if !e.Paused {
+		t.Error("expected entry to be paused after threshold consecutive panics")
+	}
+}
+
+func TestPauseOnErrorPausesAndResumes(t *testing.T) {
+	job := &failingJob{failuresLeft: 100}
+
+	cron := New()
+	entry := &Entry{Name: "flaky", Job: job, WrappedJob: job}
+	opt := PauseOnError(2, 50*time.Millisecond)
+	opt(cron, entry)
+	cron.addEntry(entry)
+
+	entry.WrappedJob.Run()
+	entry.WrappedJob.Run()
+
+	e, ok := cron.Entry("flaky")
+	if !ok {
+		t.Fatal("expected entry to exist")
+	}
+	if !e.Paused {
+		t.Error("expected entry to be paused after threshold consecutive failures")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	e, ok = cron.Entry("flaky")
+	if !ok {
+		t.Fatal("expected entry to exist")
+	}
+	if e.Paused {
+		t.Error("expected entry to auto-resume after cooldown")
+	}
+}
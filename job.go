@@ -0,0 +1,14 @@
+package cron
+
+// Job is the interface that must be implemented by anything that can be
+// scheduled. Run is invoked by the Cron scheduler each time the job's
+// Schedule activates.
+type Job interface {
+	Run()
+}
+
+// FuncJob is a wrapper that turns a bare func() into a cron.Job.
+type FuncJob func()
+
+// Run calls f.
+func (f FuncJob) Run() { f() }
@@ -0,0 +1,60 @@
+package cron
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultLockTTL is used when a Locker is installed via WithLocker without
+// an accompanying WithLockTTL, long enough to cover most per-second and
+// per-minute jobs while still expiring promptly if a replica dies mid-run.
+const defaultLockTTL = time.Minute
+
+// Locker coordinates single-instance execution of an entry's job across
+// multiple Cron replicas that share the same schedule table - the common
+// deployment shape of several app instances with one logical cron that
+// gocron-style schedulers have popularized support for.
+//
+// Acquire must be safe for concurrent use. A lock that is simply held
+// elsewhere should report ok=false with a nil error; err is reserved for
+// failure to reach the lock backend itself. release, called once the job
+// completes, should make the key available to the next Acquire; ttl is a
+// safety net so the lock is not held forever if the process dies before
+// release runs. A Redis- or etcd-backed implementation would typically
+// acquire with a "set if not exists" plus expiry, and release by deleting
+// the key.
+type Locker interface {
+	Acquire(ctx context.Context, key string, ttl time.Duration) (release func(), ok bool, err error)
+}
+
+// MemoryLocker is an in-process Locker backed by a map. It is useful for
+// tests and single-instance deployments, but coordinates nothing across
+// processes or machines - use a Redis- or etcd-backed Locker for that.
+type MemoryLocker struct {
+	mu      sync.Mutex
+	heldTil map[string]time.Time
+}
+
+// NewMemoryLocker returns a ready-to-use MemoryLocker.
+func NewMemoryLocker() *MemoryLocker {
+	return &MemoryLocker{heldTil: make(map[string]time.Time)}
+}
+
+// Acquire implements Locker.
+func (m *MemoryLocker) Acquire(ctx context.Context, key string, ttl time.Duration) (func(), bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if until, held := m.heldTil[key]; held && time.Now().Before(until) {
+		return nil, false, nil
+	}
+
+	m.heldTil[key] = time.Now().Add(ttl)
+	release := func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		delete(m.heldTil, key)
+	}
+	return release, true, nil
+}
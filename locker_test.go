@@ -0,0 +1,43 @@
+package cron
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithLockerSkipsWhenLockHeldElsewhere(t *testing.T) {
+	locker := NewMemoryLocker()
+	release, ok, err := locker.Acquire(nil, "cron:exclusive", time.Minute)
+	if err != nil || !ok {
+		t.Fatalf("expected to acquire the lock directly, got ok=%v err=%v", ok, err)
+	}
+	defer release()
+
+	var calls int32
+	cron := New(WithLocker(locker), WithLockTTL(time.Minute))
+	cron.AddFunc("* * * * * ?", func() { calls++ }, "exclusive")
+	cron.Start()
+	defer cron.Stop()
+
+	time.Sleep(OneSecond)
+	if calls != 0 {
+		t.Errorf("expected the run to be skipped while the lock is held elsewhere, got %d calls", calls)
+	}
+}
+
+func TestWithLockerRunsWhenLockIsFree(t *testing.T) {
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+
+	cron := New(WithLocker(NewMemoryLocker()))
+	cron.AddFunc("* * * * * ?", func() { wg.Done() }, "free")
+	cron.Start()
+	defer cron.Stop()
+
+	select {
+	case <-time.After(OneSecond):
+		t.Error("expected job runs when the lock is free")
+	case <-wait(wg):
+	}
+}
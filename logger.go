@@ -0,0 +1,51 @@
+package cron
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"strings"
+)
+
+// Logger is the interface used internally by Cron to log job starts, panic
+// recovery, parse failures, entry add/remove, and scheduler wakeups.
+// Applications with their own structured logging stack can implement this
+// interface (and install it via WithLogger) instead of scraping stdlib log
+// output.
+type Logger interface {
+	// Info logs routine events, such as starting a job or adding an entry.
+	Info(msg string, keysAndValues ...interface{})
+	// Error logs an abnormal event, along with the error that caused it.
+	Error(err error, msg string, keysAndValues ...interface{})
+}
+
+// DefaultLogger is a Logger implementation that writes to log.Default().
+var DefaultLogger Logger = printfLogger{log.Default()}
+
+// DiscardLogger is a Logger that discards everything logged to it.
+var DiscardLogger Logger = printfLogger{log.New(io.Discard, "", 0)}
+
+// printfLogger adapts a stdlib *log.Logger to the Logger interface,
+// rendering keysAndValues as "key=value" pairs.
+type printfLogger struct {
+	logger *log.Logger
+}
+
+func (pl printfLogger) Info(msg string, keysAndValues ...interface{}) {
+	pl.logger.Printf("%s", formatf(msg, keysAndValues...))
+}
+
+func (pl printfLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	keysAndValues = append(keysAndValues, "error", err)
+	pl.logger.Printf("%s", formatf(msg, keysAndValues...))
+}
+
+func formatf(msg string, keysAndValues ...interface{}) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		b.WriteString(", ")
+		fmt.Fprintf(&b, "%v=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+	return b.String()
+}
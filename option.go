@@ -0,0 +1,60 @@
+package cron
+
+import "time"
+
+// Option configures a Cron during construction via New.
+type Option func(*Cron)
+
+// WithLocation overrides the timezone of the Cron.
+func WithLocation(loc *time.Location) Option {
+	return func(c *Cron) {
+		c.location = loc
+	}
+}
+
+// WithLogger overrides the Logger used to report internal events (job
+// starts, panic recovery, parse failures, entry add/remove, and scheduler
+// wakeups). The default is DefaultLogger.
+func WithLogger(logger Logger) Option {
+	return func(c *Cron) {
+		c.logger = logger
+	}
+}
+
+// WithParser overrides the ScheduleParser used to interpret job specs. The
+// default accepts the package-level 6-field format understood by Parse.
+func WithParser(p ScheduleParser) Option {
+	return func(c *Cron) {
+		c.parser = p
+	}
+}
+
+// WithChain sets the default Chain of JobWrappers applied to every entry
+// added to the Cron, in place of the default chain (panic recovery
+// only). Use AddJobWithChain or ScheduleWithChain to override it for an
+// individual entry.
+func WithChain(wrappers ...JobWrapper) Option {
+	return func(c *Cron) {
+		c.chain = NewChain(wrappers...)
+	}
+}
+
+// WithLocker installs a Locker so that every entry's job is only run by
+// whichever Cron replica currently holds the lock for "cron:" + the
+// entry's name, skipping (and logging) the tick otherwise. This is the hook
+// for the common deployment shape of multiple app replicas sharing one
+// schedule table.
+func WithLocker(locker Locker) Option {
+	return func(c *Cron) {
+		c.locker = locker
+	}
+}
+
+// WithLockTTL overrides the TTL passed to Locker.Acquire; it has no effect
+// unless WithLocker is also used. The default, defaultLockTTL, should be
+// raised for jobs whose runtime can exceed a minute.
+func WithLockTTL(ttl time.Duration) Option {
+	return func(c *Cron) {
+		c.lockTTL = ttl
+	}
+}
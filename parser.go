@@ -0,0 +1,217 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors identifying the class of a field parse failure, so that
+// callers (e.g. an admission-webhook-style validator) can distinguish them
+// with errors.Is without scraping message text.
+var (
+	// ErrOutOfRange means a value, or the bounds of a range, fell outside
+	// what the field allows.
+	ErrOutOfRange = errors.New("cron: value out of range")
+	// ErrParseInt means a numeric token could not be parsed as an integer.
+	ErrParseInt = errors.New("cron: failed to parse integer")
+	// ErrBadField means a field was malformed in some other way, e.g. too
+	// many hyphens or slashes, or the wrong number of fields altogether.
+	ErrBadField = errors.New("cron: malformed field")
+)
+
+// FieldError reports a parse failure against a specific cron field (e.g.
+// "minute", "day-of-week"), wrapping one of ErrOutOfRange, ErrParseInt, or
+// ErrBadField.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("cron: invalid %s field: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// ScheduleParser is the interface for turning a spec string into a
+// Schedule. It is implemented by the package-level Parse function (wrapped
+// by defaultParser) so that a Cron's parser can be swapped out via
+// WithParser.
+type ScheduleParser interface {
+	Parse(spec string) (Schedule, error)
+}
+
+// defaultParser adapts the package-level Parse function to ScheduleParser.
+type defaultParser struct{}
+
+func (defaultParser) Parse(spec string) (Schedule, error) {
+	return Parse(spec)
+}
+
+// Parse returns a new Schedule representing the given spec. It understands
+// the quartz-like 6-field format "seconds minutes hours day-of-month month
+// day-of-week" (each field accepts "*", "?", lists, ranges, and steps), as
+// well as the "@every <duration>" descriptor.
+func Parse(spec string) (Schedule, error) {
+	if strings.HasPrefix(spec, "@every ") {
+		return parseEvery(spec)
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("%w: expected 6 fields, found %d: %q", ErrBadField, len(fields), spec)
+	}
+
+	var err error
+	parse := func(name, field string, r bounds) uint64 {
+		if err != nil {
+			return 0
+		}
+		var bits uint64
+		bits, err = parseField(field, r)
+		if err != nil {
+			err = &FieldError{Field: name, Err: err}
+		}
+		return bits
+	}
+
+	schedule := &SpecSchedule{
+		Second: parse("second", fields[0], seconds),
+		Minute: parse("minute", fields[1], minutes),
+		Hour:   parse("hour", fields[2], hours),
+		Dom:    parse("day-of-month", fields[3], dom),
+		Month:  parse("month", fields[4], months),
+		Dow:    parse("day-of-week", fields[5], dow),
+	}
+	if err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// NextScheduledTime parses spec once and returns the next activation time
+// strictly after from, without registering a job. It is useful for
+// computing a "next run at ..." preview, or for validating a candidate spec
+// before it is persisted.
+func NextScheduledTime(spec string, from time.Time) (time.Time, error) {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(from), nil
+}
+
+func parseEvery(spec string) (Schedule, error) {
+	duration, err := time.ParseDuration(strings.TrimPrefix(spec, "@every "))
+	if err != nil {
+		return nil, fmt.Errorf("cron: failed to parse duration %q: %v", spec, err)
+	}
+	return Every(duration), nil
+}
+
+// parseField parses a single cron field, which may be a comma-separated
+// list of ranges, into the bits it represents.
+func parseField(field string, r bounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(field, ",") {
+		bit, err := parseRange(expr, r)
+		if err != nil {
+			return 0, err
+		}
+		bits |= bit
+	}
+	return bits, nil
+}
+
+// parseRange parses a single range expression such as "*", "?", "5", "1-10",
+// or "*/2" into the bits it represents.
+func parseRange(expr string, r bounds) (uint64, error) {
+	rangeAndStep := strings.Split(expr, "/")
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	singleDigit := len(lowAndHigh) == 1
+
+	var (
+		start, end uint
+		extra      uint64
+		err        error
+	)
+
+	if lowAndHigh[0] == "*" || lowAndHigh[0] == "?" {
+		start, end = r.min, r.max
+		extra = starBit
+	} else {
+		start, err = parseIntOrName(lowAndHigh[0], r.names)
+		if err != nil {
+			return 0, err
+		}
+		switch len(lowAndHigh) {
+		case 1:
+			end = start
+		case 2:
+			end, err = parseIntOrName(lowAndHigh[1], r.names)
+			if err != nil {
+				return 0, err
+			}
+		default:
+			return 0, fmt.Errorf("%w: too many hyphens: %q", ErrBadField, expr)
+		}
+	}
+
+	var step uint
+	switch len(rangeAndStep) {
+	case 1:
+		step = 1
+	case 2:
+		step, err = parseUint(rangeAndStep[1])
+		if err != nil {
+			return 0, err
+		}
+		if singleDigit {
+			end = r.max
+		}
+	default:
+		return 0, fmt.Errorf("%w: too many slashes: %q", ErrBadField, expr)
+	}
+
+	if start < r.min {
+		return 0, fmt.Errorf("%w: beginning of range (%d) below minimum (%d): %q", ErrOutOfRange, start, r.min, expr)
+	}
+	if end > r.max {
+		return 0, fmt.Errorf("%w: end of range (%d) above maximum (%d): %q", ErrOutOfRange, end, r.max, expr)
+	}
+	if start > end {
+		return 0, fmt.Errorf("%w: beginning of range (%d) beyond end of range (%d): %q", ErrOutOfRange, start, end, expr)
+	}
+	if step == 0 {
+		return 0, fmt.Errorf("%w: step of range should be a positive number: %q", ErrBadField, expr)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << i
+	}
+	return bits | extra, nil
+}
+
+func parseIntOrName(expr string, names map[string]uint) (uint, error) {
+	if names != nil {
+		if v, ok := names[strings.ToLower(expr)]; ok {
+			return v, nil
+		}
+	}
+	return parseUint(expr)
+}
+
+func parseUint(expr string) (uint, error) {
+	num, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("%w: failed to parse %q as an integer: %v", ErrParseInt, expr, err)
+	}
+	if num < 0 {
+		return 0, fmt.Errorf("%w: negative number (%d) not allowed: %q", ErrOutOfRange, num, expr)
+	}
+	return uint(num), nil
+}
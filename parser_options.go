@@ -0,0 +1,134 @@
+package cron
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseOption is a bitmask of the fields a Parser will accept. Combine them
+// with bitwise-or to pick a dialect, e.g.
+//
+//	NewParser(Seconds | Minutes | Hours | Dom | Month | Dow | Descriptor)
+//
+// for quartz-like (6-field, with seconds) compatibility, or
+//
+//	NewParser(Minutes | Hours | Dom | Month | Dow | Descriptor)
+//
+// for the mainstream vixie-cron 5-field form.
+type ParseOption int
+
+const (
+	Seconds ParseOption = 1 << iota
+	Minutes
+	Hours
+	Dom
+	Month
+	Dow
+	Descriptor
+)
+
+// fieldSpec describes one positional field a Parser may accept.
+type fieldSpec struct {
+	option ParseOption
+	name   string
+	bounds bounds
+}
+
+// fieldOrder lists every field a Parser can be configured to accept, in the
+// order they appear in a spec string.
+var fieldOrder = []fieldSpec{
+	{Seconds, "second", seconds},
+	{Minutes, "minute", minutes},
+	{Hours, "hour", hours},
+	{Dom, "day-of-month", dom},
+	{Month, "month", months},
+	{Dow, "day-of-week", dow},
+}
+
+// Parser is a configurable cron spec parser, letting callers choose which
+// fields a spec must contain instead of the 6-field form fixed by Parse.
+// Construct one with NewParser; the zero value is not usable.
+type Parser struct {
+	options ParseOption
+}
+
+// NewParser returns a Parser accepting the fields named in options, plus
+// (always) the "@every <duration>" descriptor.
+func NewParser(options ParseOption) Parser {
+	return Parser{options: options}
+}
+
+// Parse returns a new Schedule representing the given spec, honoring the
+// fields p was configured to accept.
+func (p Parser) Parse(spec string) (Schedule, error) {
+	if strings.HasPrefix(spec, "@every ") {
+		return parseEvery(spec)
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		if p.options&Descriptor == 0 {
+			return nil, fmt.Errorf("%w: descriptors are not enabled for this parser: %q", ErrBadField, spec)
+		}
+		return parseDescriptor(spec)
+	}
+
+	var active []fieldSpec
+	for _, f := range fieldOrder {
+		if p.options&f.option != 0 {
+			active = append(active, f)
+		}
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != len(active) {
+		return nil, fmt.Errorf("%w: expected %d fields, found %d: %q", ErrBadField, len(active), len(fields), spec)
+	}
+
+	schedule := &SpecSchedule{}
+	if p.options&Seconds == 0 {
+		// No seconds field: fire only at :00, like a standard crontab.
+		schedule.Second = 1 << 0
+	}
+
+	for i, f := range active {
+		bits, err := parseField(fields[i], f.bounds)
+		if err != nil {
+			return nil, &FieldError{Field: f.name, Err: err}
+		}
+		switch f.option {
+		case Seconds:
+			schedule.Second = bits
+		case Minutes:
+			schedule.Minute = bits
+		case Hours:
+			schedule.Hour = bits
+		case Dom:
+			schedule.Dom = bits
+		case Month:
+			schedule.Month = bits
+		case Dow:
+			schedule.Dow = bits
+		}
+	}
+	return schedule, nil
+}
+
+// descriptors maps the supported @-prefixed shorthand to its 6-field
+// equivalent, understood by the package-level Parse.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+func parseDescriptor(spec string) (Schedule, error) {
+	equivalent, ok := descriptors[spec]
+	if !ok {
+		return nil, fmt.Errorf("%w: unrecognized descriptor: %q", ErrBadField, spec)
+	}
+	return Parse(equivalent)
+}
@@ -0,0 +1,67 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParserFiveFieldForm(t *testing.T) {
+	p := NewParser(Minutes | Hours | Dom | Month | Dow | Descriptor)
+
+	schedule, err := p.Parse("30 4 1 * *")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	next := schedule.Next(from)
+	want := time.Date(2026, time.January, 1, 4, 30, 0, 0, time.UTC)
+	if !next.Equal(want) {
+		t.Errorf("expected %v, got %v", want, next)
+	}
+
+	if _, err := p.Parse("* 30 4 1 * *"); err == nil {
+		t.Error("expected an error for a 6-field spec given a 5-field parser")
+	}
+}
+
+func TestParserDescriptors(t *testing.T) {
+	p := NewParser(Minutes | Hours | Dom | Month | Dow | Descriptor)
+
+	for _, desc := range []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"} {
+		if _, err := p.Parse(desc); err != nil {
+			t.Errorf("%s: unexpected error: %v", desc, err)
+		}
+	}
+
+	pWithoutDescriptors := NewParser(Minutes | Hours | Dom | Month | Dow)
+	if _, err := pWithoutDescriptors.Parse("@hourly"); err == nil {
+		t.Error("expected an error when descriptors are not enabled")
+	}
+}
+
+func TestParserFieldErrors(t *testing.T) {
+	_, err := Parse("* * * * * 99")
+	if err == nil {
+		t.Fatal("expected an error for an out-of-range day-of-week")
+	}
+	if !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange, got %v", err)
+	}
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("expected a *FieldError, got %T", err)
+	}
+	if fieldErr.Field != "day-of-week" {
+		t.Errorf("expected field %q, got %q", "day-of-week", fieldErr.Field)
+	}
+
+	if _, err := Parse("* * * * abc *"); !errors.Is(err, ErrParseInt) {
+		t.Errorf("expected ErrParseInt, got %v", err)
+	}
+
+	if _, err := Parse("* * * *"); !errors.Is(err, ErrBadField) {
+		t.Errorf("expected ErrBadField, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package cron
+
+import "time"
+
+// Schedule describes a job's duty cycle.
+type Schedule interface {
+	// Next returns the next activation time, later than the given time.
+	// Next is invoked initially when the job is added, and then each time
+	// the job is run.
+	Next(time.Time) time.Time
+}
+
+// ConstantDelaySchedule represents a simple recurring duty cycle, e.g.
+// "Every 5 minutes". It does not support jobs more frequent than once a
+// second.
+type ConstantDelaySchedule struct {
+	Delay time.Duration
+}
+
+// Every returns a crontab Schedule that activates once every duration.
+// Delays of less than a second are not supported; they will be rounded up to
+// 1 second, and any fractional second is truncated away.
+func Every(duration time.Duration) ConstantDelaySchedule {
+	if duration < time.Second {
+		duration = time.Second
+	}
+	return ConstantDelaySchedule{
+		Delay: duration - time.Duration(duration.Nanoseconds())%time.Second,
+	}
+}
+
+// Next returns the next time this should be run, rounded so that the next
+// activation time falls on the second.
+func (schedule ConstantDelaySchedule) Next(t time.Time) time.Time {
+	return t.Add(schedule.Delay - time.Duration(t.Nanosecond())*time.Nanosecond)
+}